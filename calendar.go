@@ -0,0 +1,106 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kind distinguishes the versioning scheme a Version was parsed with.
+type Kind int
+
+// The Kinds a Version can have.
+const (
+	KindSemver Kind = iota
+	KindCalendar
+)
+
+// calendarPattern accepts date-based schemes such as "2024.03",
+// "2024.03.15" or "22.04.0-rc2": two or three dot-separated numeric
+// components (no leading-zero restriction, since calendar components are
+// dates, e.g. "03" for March), followed by the usual SemVer 2.0.0
+// pre-release and build metadata grammar.
+var calendarPattern = regexp.MustCompile(
+	`^(\d+)\.(\d+)(?:\.(\d+))?` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`,
+)
+
+// ParseCalendar parses str as a CalVer-style date-based version: a
+// year.month[.day] core (e.g. "2024.03.15" or "2024.03"), optionally
+// followed by a pre-release and/or build metadata suffix using the same
+// grammar as NewStrictVersion (e.g. "2024.03.15-beta.1+build.42").
+func ParseCalendar(str string) (*Version, error) {
+	m := calendarPattern.FindStringSubmatch(str)
+	if m == nil {
+		return nil, ErrInvalidVersionString
+	}
+
+	ver := &Version{calendar: true}
+	year, err := strconv.Atoi(m[1])
+	if err != nil {
+		return nil, err
+	}
+	month, err := strconv.Atoi(m[2])
+	if err != nil {
+		return nil, err
+	}
+	ver.version[0] = year
+	ver.version[1] = month
+	ver.calRaw[0], ver.calRaw[1] = m[1], m[2]
+	if m[3] == "" {
+		ver.patchOmitted = true
+	} else {
+		day, err := strconv.Atoi(m[3])
+		if err != nil {
+			return nil, err
+		}
+		ver.version[2] = day
+		ver.calRaw[2] = m[3]
+	}
+	if m[4] != "" {
+		ver.preIdents = strings.Split(m[4], ".")
+	}
+	ver.buildMeta = m[5]
+
+	return ver, nil
+}
+
+// Kind reports which versioning scheme t was parsed with.
+func (t *Version) Kind() Kind {
+	if t.calendar {
+		return KindCalendar
+	}
+	return KindSemver
+}
+
+// IsCalendar reports whether t was parsed by ParseCalendar.
+func (t *Version) IsCalendar() bool {
+	return t.calendar
+}
+
+// calendarString reconstructs the textual form of a Version parsed by
+// ParseCalendar.
+func (t *Version) calendarString() string {
+	var b strings.Builder
+	b.WriteString(t.calRaw[0])
+	b.WriteByte('.')
+	b.WriteString(t.calRaw[1])
+	if !t.patchOmitted {
+		b.WriteByte('.')
+		b.WriteString(t.calRaw[2])
+	}
+	if len(t.preIdents) > 0 {
+		b.WriteByte('-')
+		b.WriteString(strings.Join(t.preIdents, "."))
+	}
+	if t.buildMeta != "" {
+		b.WriteByte('+')
+		b.WriteString(t.buildMeta)
+	}
+	return b.String()
+}
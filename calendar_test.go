@@ -0,0 +1,65 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "testing"
+
+func TestParseCalendarRoundTrip(t *testing.T) {
+	cases := []string{
+		"2024.03",
+		"2024.03.15",
+		"22.04.0-rc2",
+		"2024.03.15-beta.1+build.42",
+	}
+	for _, c := range cases {
+		v, err := ParseCalendar(c)
+		if err != nil {
+			t.Fatalf("ParseCalendar(%q): %v", c, err)
+		}
+		if !v.IsCalendar() || v.Kind() != KindCalendar {
+			t.Errorf("ParseCalendar(%q): expected KindCalendar", c)
+		}
+		if got := v.String(); got != c {
+			t.Errorf("ParseCalendar(%q).String() = %q", c, got)
+		}
+	}
+}
+
+func TestParseCalendarRejectsLeadingZeroPrerelease(t *testing.T) {
+	if _, err := ParseCalendar("2024.03.15-01"); err == nil {
+		t.Error("ParseCalendar(\"2024.03.15-01\"): expected error, got none")
+	}
+}
+
+func TestParseCalendarOrdering(t *testing.T) {
+	older, err := ParseCalendar("2024.03")
+	if err != nil {
+		t.Fatalf("ParseCalendar: %v", err)
+	}
+	newer, err := ParseCalendar("2024.04")
+	if err != nil {
+		t.Fatalf("ParseCalendar: %v", err)
+	}
+	if !older.Less(newer) {
+		t.Errorf("expected 2024.03 < 2024.04")
+	}
+}
+
+func TestCompareCalendarAndSemverIsTotalOrder(t *testing.T) {
+	cal, err := ParseCalendar("2024.03")
+	if err != nil {
+		t.Fatalf("ParseCalendar: %v", err)
+	}
+	sv, err := NewVersion("2024.3.0")
+	if err != nil {
+		t.Fatalf("NewVersion: %v", err)
+	}
+	if Compare(*cal, *sv) != -Compare(*sv, *cal) {
+		t.Errorf("Compare across Kinds is not antisymmetric")
+	}
+	if Compare(*cal, *sv) == 0 {
+		t.Errorf("a calendar Version and a semver Version should never compare equal")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "testing"
+
+// FuzzParse exercises Version.Parse's hand-rolled state machine directly,
+// looking for panics and other crashes rather than asserting a particular
+// accept/reject outcome.
+func FuzzParse(f *testing.F) {
+	seeds := []string{
+		"1.2.3",
+		"1.2-634.0-99.8",
+		"1.2.3_alpha",
+		"1.0+build42",
+		"",
+		"1.2.3.4.5",
+		"01.02.03",
+		"-1.2.3",
+		"1.2.3\x00",
+		"999999999999999999999999999999.0.0",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = NewVersion(s)
+	})
+}
+
+// FuzzParseRange exercises ParseRange the same way.
+func FuzzParseRange(f *testing.F) {
+	seeds := []string{
+		">=1.2.0 <2.0.0",
+		"~1.2.3",
+		"^1.2.3",
+		"1.2.x",
+		"1.2.3 - 1.4.0",
+		"1.0.0-beta || 2.x",
+		"",
+		"1.2.3 -",
+		"~",
+		"^0.0.0",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		_, _ = ParseRange(s)
+	})
+}
+
+// FuzzRoundTrip asserts that Parse(v.String()) reproduces v, for every v
+// that Parse accepts.
+func FuzzRoundTrip(f *testing.F) {
+	seeds := []string{
+		"1.2.3",
+		"1.2",
+		"1.2.3.4",
+		"1.2.3-alpha",
+		"1.2.3-alpha.1",
+		"1.2.3+build42",
+		"1.2-634.0-99.8",
+	}
+	for _, s := range seeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		v, err := NewVersion(s)
+		if err != nil {
+			return
+		}
+		v2, err := NewVersion(v.String())
+		if err != nil {
+			t.Fatalf("String() of accepted %q produced %q, which fails to reparse: %v", s, v.String(), err)
+		}
+		if Compare(*v, *v2) != 0 {
+			t.Fatalf("%q -> %q -> %q is not idempotent", s, v.String(), v2.String())
+		}
+	})
+}
@@ -0,0 +1,31 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "encoding/json"
+
+// MarshalText implements encoding.TextMarshaler.
+func (t *Version) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Version) UnmarshalText(text []byte) error {
+	return t.Parse(string(text))
+}
+
+// MarshalJSON implements json.Marshaler, encoding the Version as a JSON string.
+func (t *Version) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a JSON string into t.
+func (t *Version) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+	return t.Parse(str)
+}
@@ -0,0 +1,51 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSONRoundTrip(t *testing.T) {
+	cases := []string{"1.2.3", "1.2", "1.2.3.4", "1.2.3-alpha", "1.2.3-alpha.1", "1.2.3+build42"}
+	for _, c := range cases {
+		v, err := NewVersion(c)
+		if err != nil {
+			t.Fatalf("NewVersion(%q): %v", c, err)
+		}
+
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal(%q): %v", c, err)
+		}
+
+		var got Version
+		if err := json.Unmarshal(data, &got); err != nil {
+			t.Fatalf("Unmarshal(%s): %v", data, err)
+		}
+		if Compare(*v, got) != 0 {
+			t.Errorf("%q: round-tripped to a different Version (got %q)", c, got.String())
+		}
+	}
+}
+
+func TestParseStringIdempotence(t *testing.T) {
+	cases := []string{"1.2.3", "1.0.0-beta", "1.2.3_alpha", "1.0+build42"}
+	for _, c := range cases {
+		v, err := NewVersion(c)
+		if err != nil {
+			t.Fatalf("NewVersion(%q): %v", c, err)
+		}
+		s := v.String()
+		v2, err := NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q) (from String() of %q): %v", s, c, err)
+		}
+		if Compare(*v, *v2) != 0 {
+			t.Errorf("Parse(%q).String() = %q, which parses back to a different Version", c, s)
+		}
+	}
+}
@@ -0,0 +1,409 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidRangeString is returned when a range expression cannot be parsed.
+var ErrInvalidRangeString = errors.New("Given string does not resemble a Range")
+
+// Range is a predicate over Versions, built up from comparator expressions
+// such as ">=1.2.0", "~1.2.3", "^1.2.3", "1.2.x" or "1.2.3 - 1.4.0",
+// optionally combined with AND/OR.
+type Range struct {
+	match func(Version) bool
+	str   string
+}
+
+// Match reports whether v satisfies the Range.
+func (r Range) Match(v Version) bool {
+	if r.match == nil {
+		return true
+	}
+	return r.match(v)
+}
+
+// String returns the expression the Range was parsed from.
+func (r Range) String() string {
+	return r.str
+}
+
+// AND returns a Range that matches only Versions satisfying both r and o.
+func (r Range) AND(o Range) Range {
+	return Range{
+		match: func(v Version) bool { return r.Match(v) && o.Match(v) },
+		str:   strings.TrimSpace(r.str + " " + o.str),
+	}
+}
+
+// OR returns a Range that matches Versions satisfying either r or o.
+func (r Range) OR(o Range) Range {
+	return Range{
+		match: func(v Version) bool { return r.Match(v) || o.Match(v) },
+		str:   r.str + " || " + o.str,
+	}
+}
+
+// ParseRange parses a range expression, e.g. ">=1.2.0 <2.0.0", "~1.2.3",
+// "^1.2.3", "1.2.x", "1.2.3 - 1.4.0" or a "||"-separated disjunction thereof.
+func ParseRange(s string) (Range, error) {
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" {
+		return Range{}, ErrInvalidRangeString
+	}
+
+	orParts := strings.Split(trimmed, "||")
+	var result Range
+	for i, part := range orParts {
+		r, err := parseRangeSet(strings.TrimSpace(part))
+		if err != nil {
+			return Range{}, err
+		}
+		if i == 0 {
+			result = r
+		} else {
+			result = result.OR(r)
+		}
+	}
+	result.str = trimmed
+	return result, nil
+}
+
+// parseRangeSet parses a space-separated, AND'd list of comparators,
+// including the "lo - hi" hyphen-range form.
+func parseRangeSet(s string) (Range, error) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return Range{}, ErrInvalidRangeString
+	}
+
+	if len(fields) == 3 && fields[1] == "-" {
+		return parseHyphenRange(fields[0], fields[2])
+	}
+
+	var result Range
+	for i, f := range fields {
+		if f == "-" {
+			return Range{}, ErrInvalidRangeString
+		}
+		r, err := parseComparator(f)
+		if err != nil {
+			return Range{}, err
+		}
+		if i == 0 {
+			result = r
+		} else {
+			result = result.AND(r)
+		}
+	}
+	return result, nil
+}
+
+// partialVersion is a major[.minor[.patch]] version that may carry
+// wildcard components (x, X, *) and/or a pre-release suffix.
+type partialVersion struct {
+	major, minor, patch             int
+	majorWild, minorWild, patchWild bool
+	pre                             string
+	hasPre                          bool
+}
+
+func parsePartial(s string) (partialVersion, error) {
+	var pv partialVersion
+
+	base := s
+	if idx := strings.IndexByte(s, '-'); idx >= 0 {
+		pv.hasPre = true
+		pv.pre = s[idx+1:]
+		base = s[:idx]
+		if pv.pre == "" {
+			return pv, ErrInvalidRangeString
+		}
+	}
+
+	parts := strings.Split(base, ".")
+	if len(parts) == 0 || len(parts) > 3 {
+		return pv, ErrInvalidRangeString
+	}
+
+	nums := [3]*int{&pv.major, &pv.minor, &pv.patch}
+	wilds := [3]*bool{&pv.majorWild, &pv.minorWild, &pv.patchWild}
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			*wilds[i] = true
+			continue
+		}
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return pv, ErrInvalidRangeString
+		}
+		*nums[i] = n
+	}
+	for i := len(parts); i < 3; i++ {
+		*wilds[i] = true
+	}
+	return pv, nil
+}
+
+// isWild reports whether any component of pv is a wildcard.
+func (pv partialVersion) isWild() bool {
+	return pv.majorWild || pv.minorWild || pv.patchWild
+}
+
+// version returns the exact Version denoted by pv, ignoring wildcards.
+func (pv partialVersion) version() (Version, error) {
+	if pv.hasPre {
+		var tmp Version
+		full := strconv.Itoa(pv.major) + "." + strconv.Itoa(pv.minor) + "." + strconv.Itoa(pv.patch) + "-" + pv.pre
+		if err := tmp.Parse(full); err != nil {
+			return Version{}, err
+		}
+		return tmp, nil
+	}
+	var v Version
+	v.version[0] = pv.major
+	v.version[1] = pv.minor
+	v.version[2] = pv.patch
+	return v, nil
+}
+
+// bumpMinor returns {major, minor+1, 0}.
+func bumpMinor(major, minor int) Version {
+	var v Version
+	v.version[0] = major
+	v.version[1] = minor + 1
+	return v
+}
+
+// bumpMajor returns {major+1, 0, 0}.
+func bumpMajor(major int) Version {
+	var v Version
+	v.version[0] = major + 1
+	return v
+}
+
+// bumpPatch returns {major, minor, patch+1}.
+func bumpPatch(major, minor, patch int) Version {
+	var v Version
+	v.version[0] = major
+	v.version[1] = minor
+	v.version[2] = patch + 1
+	return v
+}
+
+// prereleaseAllowed implements the usual semver range rule: a pre-release
+// Version only satisfies a Range if one of the Range's own boundaries
+// shares its major.minor.patch and is itself a pre-release.
+func prereleaseAllowed(candidate, boundary Version) bool {
+	if !candidate.IsAPreRelease() {
+		return true
+	}
+	return boundary.IsAPreRelease() &&
+		candidate.version[0] == boundary.version[0] &&
+		candidate.version[1] == boundary.version[1] &&
+		candidate.version[2] == boundary.version[2]
+}
+
+func atLeast(boundary Version, str string) Range {
+	return Range{
+		match: func(v Version) bool {
+			return prereleaseAllowed(v, boundary) && Compare(v, boundary) >= 0
+		},
+		str: str,
+	}
+}
+
+func atMost(boundary Version, str string) Range {
+	return Range{
+		match: func(v Version) bool {
+			return prereleaseAllowed(v, boundary) && Compare(v, boundary) <= 0
+		},
+		str: str,
+	}
+}
+
+func lessThan(boundary Version, str string) Range {
+	return Range{
+		match: func(v Version) bool {
+			return prereleaseAllowed(v, boundary) && Compare(v, boundary) < 0
+		},
+		str: str,
+	}
+}
+
+func greaterThan(boundary Version, str string) Range {
+	return Range{
+		match: func(v Version) bool {
+			return prereleaseAllowed(v, boundary) && Compare(v, boundary) > 0
+		},
+		str: str,
+	}
+}
+
+func equalTo(boundary Version, str string) Range {
+	return Range{
+		match: func(v Version) bool {
+			return prereleaseAllowed(v, boundary) && Compare(v, boundary) == 0
+		},
+		str: str,
+	}
+}
+
+// parseComparator parses a single token such as ">=1.2.3", "~1.2", "^1.2.3",
+// "1.2.x" or a bare "1.2.3".
+func parseComparator(tok string) (Range, error) {
+	op, rest := splitOperator(tok)
+
+	switch op {
+	case "~":
+		return parseTilde(rest, tok)
+	case "^":
+		return parseCaret(rest, tok)
+	}
+
+	pv, err := parsePartial(rest)
+	if err != nil {
+		return Range{}, err
+	}
+
+	if pv.isWild() {
+		return parseWildcard(op, pv, tok)
+	}
+
+	v, err := pv.version()
+	if err != nil {
+		return Range{}, err
+	}
+	switch op {
+	case ">=":
+		return atLeast(v, tok), nil
+	case "<=":
+		return atMost(v, tok), nil
+	case ">":
+		return greaterThan(v, tok), nil
+	case "<":
+		return lessThan(v, tok), nil
+	case "=", "":
+		return equalTo(v, tok), nil
+	}
+	return Range{}, ErrInvalidRangeString
+}
+
+// splitOperator splits a comparator token into its leading operator
+// (one of "", "=", ">", "<", ">=", "<=", "~", "^") and the remaining operand.
+func splitOperator(tok string) (op, rest string) {
+	for _, candidate := range []string{">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(tok, candidate) {
+			return candidate, strings.TrimSpace(tok[len(candidate):])
+		}
+	}
+	return "", tok
+}
+
+// parseWildcard expands a wildcard operand like "1.2.x" or "1.x" or "*"
+// into a half-open range. A leading comparator other than "=" or "" on a
+// wildcard operand is rejected, matching the usual range grammar.
+func parseWildcard(op string, pv partialVersion, tok string) (Range, error) {
+	if op != "" && op != "=" {
+		return Range{}, ErrInvalidRangeString
+	}
+	if pv.majorWild {
+		// "*", "x", "X"
+		return Range{match: func(Version) bool { return true }, str: tok}, nil
+	}
+	// "1.x" or "1.2.x": pin everything up to the last concrete component.
+	lo, err := pv.version()
+	if err != nil {
+		return Range{}, err
+	}
+	var hi Version
+	if pv.patchWild && !pv.minorWild {
+		hi = bumpMinor(pv.major, pv.minor)
+	} else {
+		hi = bumpMajor(pv.major)
+	}
+	return atLeast(lo, tok).AND(lessThan(hi, tok)), nil
+}
+
+// parseTilde expands "~1.2.3" to ">=1.2.3 <1.3.0", "~1.2" to ">=1.2.0 <1.3.0"
+// and "~1" to ">=1.0.0 <2.0.0".
+func parseTilde(rest string, tok string) (Range, error) {
+	pv, err := parsePartial(rest)
+	if err != nil {
+		return Range{}, err
+	}
+	lo, err := pv.version()
+	if err != nil {
+		return Range{}, err
+	}
+	var hi Version
+	if pv.minorWild {
+		hi = bumpMajor(pv.major)
+	} else {
+		hi = bumpMinor(pv.major, pv.minor)
+	}
+	return atLeast(lo, tok).AND(lessThan(hi, tok)), nil
+}
+
+// parseCaret expands "^x.y.z" to the widest range that keeps the left-most
+// non-zero component fixed, per the usual caret-range rules.
+func parseCaret(rest string, tok string) (Range, error) {
+	pv, err := parsePartial(rest)
+	if err != nil {
+		return Range{}, err
+	}
+	lo, err := pv.version()
+	if err != nil {
+		return Range{}, err
+	}
+	var hi Version
+	switch {
+	case pv.major > 0 || pv.minorWild:
+		hi = bumpMajor(pv.major)
+	case pv.minor > 0 || pv.patchWild:
+		hi = bumpMinor(pv.major, pv.minor)
+	default:
+		hi = bumpPatch(pv.major, pv.minor, pv.patch)
+	}
+	return atLeast(lo, tok).AND(lessThan(hi, tok)), nil
+}
+
+// parseHyphenRange expands "lo - hi" to ">=lo <=hi", bumping hi to the next
+// component when it is itself a partial version (e.g. "1.2.3 - 1.4" means
+// ">=1.2.3 <1.5.0").
+func parseHyphenRange(loStr, hiStr string) (Range, error) {
+	lo, err := parsePartial(loStr)
+	if err != nil {
+		return Range{}, err
+	}
+	hi, err := parsePartial(hiStr)
+	if err != nil {
+		return Range{}, err
+	}
+
+	str := loStr + " - " + hiStr
+	loV, err := lo.version()
+	if err != nil {
+		return Range{}, err
+	}
+	if !hi.isWild() {
+		hiV, err := hi.version()
+		if err != nil {
+			return Range{}, err
+		}
+		return atLeast(loV, str).AND(atMost(hiV, str)), nil
+	}
+	var hiBound Version
+	if hi.patchWild && !hi.minorWild {
+		hiBound = bumpMinor(hi.major, hi.minor)
+	} else {
+		hiBound = bumpMajor(hi.major)
+	}
+	return atLeast(loV, str).AND(lessThan(hiBound, str)), nil
+}
@@ -0,0 +1,71 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "testing"
+
+func TestParseRangeMatch(t *testing.T) {
+	cases := []struct {
+		rng  string
+		vers map[string]bool
+	}{
+		{">=1.2.0", map[string]bool{"1.2.0": true, "1.1.9": false, "2.0.0": true}},
+		{"<=1.2.0", map[string]bool{"1.2.0": true, "1.2.1": false, "1.0.0": true}},
+		{">1.2.0", map[string]bool{"1.2.0": false, "1.2.1": true}},
+		{"<1.2.0", map[string]bool{"1.2.0": false, "1.1.9": true}},
+		{"=1.2.0", map[string]bool{"1.2.0": true, "1.2.1": false}},
+		{"1.2.0", map[string]bool{"1.2.0": true, "1.2.1": false}},
+
+		{"~1.2.3", map[string]bool{"1.2.3": true, "1.2.9": true, "1.3.0": false, "1.2.2": false}},
+		{"~1.2", map[string]bool{"1.2.0": true, "1.2.9": true, "1.3.0": false}},
+		{"~1", map[string]bool{"1.0.0": true, "1.9.0": true, "2.0.0": false}},
+
+		{"^1.2.3", map[string]bool{"1.2.3": true, "1.9.9": true, "2.0.0": false, "1.2.2": false}},
+		{"^0.2.3", map[string]bool{"0.2.3": true, "0.2.9": true, "0.3.0": false}},
+		{"^0.0.3", map[string]bool{"0.0.3": true, "0.0.4": false}},
+
+		{"*", map[string]bool{"0.0.0": true, "1.2.3": true, "9.9.9": true}},
+		{"x", map[string]bool{"0.0.0": true, "5.5.5": true}},
+		{"1.x", map[string]bool{"1.0.0": true, "1.9.9": true, "0.5.0": false, "2.5.0": false}},
+		{"1.2.x", map[string]bool{"1.2.0": true, "1.2.9": true, "1.3.0": false, "1.1.9": false}},
+
+		{"1.2.3 - 1.4.0", map[string]bool{"1.2.3": true, "1.4.0": true, "1.2.2": false, "1.4.1": false}},
+		{"1.2.3 - 1.4", map[string]bool{"1.4.9": true, "1.5.0": false}},
+
+		{"1.0.0 || 2.0.0", map[string]bool{"1.0.0": true, "2.0.0": true, "1.5.0": false}},
+
+		{">=1.2.3-alpha", map[string]bool{"1.2.3-alpha": true, "1.2.3-alpha.1": true, "1.2.3": true, "1.2.2": false}},
+		{"<2.0.0", map[string]bool{"2.0.0-alpha": false, "1.9.9": true}},
+	}
+
+	for _, c := range cases {
+		r, err := ParseRange(c.rng)
+		if err != nil {
+			t.Fatalf("ParseRange(%q): %v", c.rng, err)
+		}
+		for vs, want := range c.vers {
+			v, err := NewVersion(vs)
+			if err != nil {
+				t.Fatalf("NewVersion(%q): %v", vs, err)
+			}
+			if got := r.Match(*v); got != want {
+				t.Errorf("ParseRange(%q).Match(%q) = %v, want %v", c.rng, vs, got, want)
+			}
+		}
+	}
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	cases := []string{
+		"",
+		"1.2.3 -",
+		">=1.2.3-zzz", // "zzz" is not a recognized legacy release keyword
+	}
+	for _, c := range cases {
+		if _, err := ParseRange(c); err == nil {
+			t.Errorf("ParseRange(%q): expected error, got none", c)
+		}
+	}
+}
@@ -9,6 +9,7 @@ package semver
 import (
 	"errors"
 	"strconv"
+	"strings"
 )
 
 // Errors that are thrown when translating from a string.
@@ -61,6 +62,42 @@ type Version struct {
 	// 0–3: version, 4: releaseType, 5–8: releaseVer, 9: releaseSpecifier, 10–14: specifier
 	version [14]int
 	build   int
+
+	// hasRelease/hasSpecifier record whether Parse actually saw a '-' or
+	// '_' introducing the release/specifier group, as opposed to a group
+	// whose (possibly all-zero) fields merely default to their zero
+	// value: "1.2.3" and "1.2.3-0" both end up with version[idxRelease]
+	// == 0, but only the latter should round-trip through String() with
+	// the group present.
+	hasRelease   bool
+	hasSpecifier bool
+
+	// preIdents and buildMeta are populated instead of the fields above
+	// when the Version was parsed by NewStrictVersion (which also sets
+	// strict) or ParseCalendar: version[0:3] still holds major.minor.patch, but
+	// pre-release and build metadata follow the SemVer 2.0.0 grammar
+	// rather than this package's keyword-aware one. See strict.go and
+	// calendar.go.
+	strict    bool
+	preIdents []string
+	buildMeta string
+
+	// calendar, patchOmitted and calRaw are set by ParseCalendar:
+	// version[0:3] then holds year.month.day as integers for comparison,
+	// patchOmitted records whether the source string had a day component
+	// at all (e.g. "2024.03"), and calRaw keeps the original year/month/day
+	// text so that e.g. a zero-padded month like "03" round-trips through
+	// String().
+	calendar     bool
+	patchOmitted bool
+	calRaw       [3]string
+}
+
+// specGrammar reports whether t uses the ordered pre-release identifier
+// grammar (NewStrictVersion or ParseCalendar) rather than this package's
+// original keyword-aware one.
+func (t *Version) specGrammar() bool {
+	return t.strict || t.calendar
 }
 
 // NewVersion translates the given string, which must be free of whitespace,
@@ -96,12 +133,17 @@ func (t *Version) Parse(str string) error {
 		}
 
 		// convert
+		if fieldNum >= len(t.version) {
+			return errors.New("Version is too long")
+		}
 		if isAlpha {
 			switch {
 			case fieldNum <= idxReleaseType:
 				fieldNum = idxReleaseType
+				t.hasRelease = true
 			case fieldNum <= idxSpecifierType:
 				fieldNum = idxSpecifierType
+				t.hasSpecifier = true
 			default:
 				return ErrInvalidVersionString
 			}
@@ -131,15 +173,17 @@ func (t *Version) Parse(str string) error {
 			isAlpha = false
 		case '-', '_':
 			fromIdx = idx + 1
-			if strlen < fromIdx {
+			if strlen <= fromIdx {
 				return ErrInvalidVersionString
 			}
 			isAlpha = 'a' <= str[fromIdx] && str[fromIdx] <= 'z'
 			switch {
 			case fieldNum <= idxReleaseType:
 				fieldNum = idxReleaseType
+				t.hasRelease = true
 			case fieldNum <= idxSpecifierType:
 				fieldNum = idxSpecifierType
+				t.hasSpecifier = true
 			default:
 				return ErrInvalidVersionString
 			}
@@ -170,6 +214,92 @@ func (t *Version) Parse(str string) error {
 	return nil
 }
 
+// String reconstructs the textual form of t: the up-to-four-column main
+// version, followed by the release type/version and specifier
+// type/version groups (if set) introduced by '-', and the "+buildN"
+// suffix (if set). It is the inverse of Parse.
+//
+// Versions produced by NewStrictVersion or ParseCalendar instead
+// reconstruct the SemVer 2.0.0 form: major.minor[.patch], dot-separated
+// pre-release identifiers and free-form build metadata. See strict.go
+// and calendar.go.
+func (t *Version) String() string {
+	if t.calendar {
+		return t.calendarString()
+	}
+	if t.strict {
+		return t.strictString()
+	}
+
+	var b strings.Builder
+
+	writeColumns(&b, t.version[0:4], 1)
+
+	if t.hasRelease {
+		b.WriteByte('-')
+		writeWord(&b, releaseDesc[t.version[idxReleaseType]], t.version[idxRelease:idxRelease+4])
+	}
+
+	if t.hasSpecifier {
+		b.WriteByte('-')
+		writeWord(&b, releaseDesc[t.version[idxSpecifierType]], t.version[idxSpecifier:idxSpecifier+4])
+	}
+
+	if t.build != 0 {
+		b.WriteString("+build")
+		b.WriteString(strconv.Itoa(t.build))
+	}
+
+	return b.String()
+}
+
+// anyNonZero reports whether any column is non-zero.
+func anyNonZero(cols []int) bool {
+	for _, c := range cols {
+		if c != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// writeWord writes a release/specifier type word followed by its version
+// columns. A '.' is inserted between the word and the first column
+// because Parse drops a digit that directly follows a word at the end of
+// the string (e.g. it reads "1.2.3-alpha1" back as "1.2.3-alpha").
+func writeWord(b *strings.Builder, word string, cols []int) {
+	b.WriteString(word)
+	if word != "" && anyNonZero(cols) {
+		b.WriteByte('.')
+	}
+	// A group with no word (just a bare "-0" etc.) always needs at least
+	// one column, or it'd vanish into an empty, unparseable "-" group.
+	minColumns := 0
+	if word == "" {
+		minColumns = 1
+	}
+	writeColumns(b, cols, minColumns)
+}
+
+// writeColumns writes cols[0:n] dot-joined, where n is chosen so that at
+// least minColumns columns are written and any trailing non-zero column
+// is included.
+func writeColumns(b *strings.Builder, cols []int, minColumns int) {
+	last := minColumns - 1
+	for i := len(cols) - 1; i > last; i-- {
+		if cols[i] != 0 {
+			last = i
+			break
+		}
+	}
+	for i := 0; i <= last; i++ {
+		if i > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(strconv.Itoa(cols[i]))
+	}
+}
+
 // signDelta returns the signum of the difference,
 // which' precision can be limited by 'cuttofIdx'.
 func signDelta(a, b [14]int, cutoffIdx int) int8 {
@@ -194,12 +324,29 @@ func signDelta(a, b [14]int, cutoffIdx int) int8 {
 //   -1 if a < b
 //
 // The 'build' is not compared.
+//
+// Versions of different Kind are still given a well-defined, total order:
+// they compare as unequal by Kind alone (KindSemver < KindCalendar) without
+// attempting to relate their version numbers, since a calendar version and
+// a semver version have no meaningful chronological/precedence relation.
 func Compare(a, b Version) int {
+	if ak, bk := a.Kind(), b.Kind(); ak != bk {
+		if ak < bk {
+			return -1
+		}
+		return 1
+	}
+	if a.specGrammar() || b.specGrammar() {
+		return compareStrict(a, b)
+	}
 	return int(signDelta(a.version, b.version, 14))
 }
 
 // Less is a convenience function for sorting.
 func (t *Version) Less(o *Version) bool {
+	if t.specGrammar() || o.specGrammar() {
+		return Compare(*t, *o) < 0
+	}
 	sd := signDelta(t.version, o.version, 15)
 	return sd < 0 || (sd == 0 && t.build < o.build)
 }
@@ -227,6 +374,9 @@ func (t *Version) LimitedEqual(o *Version) bool {
 
 // IsAPreRelease is used to discriminate pre-releases.
 func (t *Version) IsAPreRelease() bool {
+	if t.specGrammar() {
+		return len(t.preIdents) > 0
+	}
 	return t.version[idxReleaseType] < common
 }
 
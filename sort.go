@@ -0,0 +1,57 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "sort"
+
+// Versions attaches the natural ordering of Version.Less to a slice of
+// *Version, implementing sort.Interface.
+type Versions []*Version
+
+func (vs Versions) Len() int           { return len(vs) }
+func (vs Versions) Swap(i, j int)      { vs[i], vs[j] = vs[j], vs[i] }
+func (vs Versions) Less(i, j int) bool { return vs[i].Less(vs[j]) }
+
+// Sort sorts vs in place, from lowest to highest Version.
+func Sort(vs []*Version) {
+	sort.Sort(Versions(vs))
+}
+
+// Latest returns the highest Version in vs, or nil if vs is empty.
+func Latest(vs []*Version) *Version {
+	var latest *Version
+	for _, v := range vs {
+		if latest == nil || latest.Less(v) {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// LatestStable returns the highest Version in vs that is not a
+// pre-release, or nil if there is none.
+func LatestStable(vs []*Version) *Version {
+	var latest *Version
+	for _, v := range vs {
+		if v.IsAPreRelease() {
+			continue
+		}
+		if latest == nil || latest.Less(v) {
+			latest = v
+		}
+	}
+	return latest
+}
+
+// Filter returns the subset of vs whose Versions satisfy r.
+func Filter(vs []*Version, r Range) []*Version {
+	var out []*Version
+	for _, v := range vs {
+		if r.Match(*v) {
+			out = append(out, v)
+		}
+	}
+	return out
+}
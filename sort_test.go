@@ -0,0 +1,66 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "testing"
+
+func mustVersions(t *testing.T, strs ...string) []*Version {
+	t.Helper()
+	vs := make([]*Version, len(strs))
+	for i, s := range strs {
+		v, err := NewVersion(s)
+		if err != nil {
+			t.Fatalf("NewVersion(%q): %v", s, err)
+		}
+		vs[i] = v
+	}
+	return vs
+}
+
+func TestSort(t *testing.T) {
+	vs := mustVersions(t, "1.2.0", "1.0.0", "1.10.0", "1.1.0")
+	Sort(vs)
+	var got []string
+	for _, v := range vs {
+		got = append(got, v.String())
+	}
+	want := []string{"1", "1.1", "1.2", "1.10"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("Sort() = %v, want %v", got, want)
+		}
+	}
+}
+
+func TestLatest(t *testing.T) {
+	vs := mustVersions(t, "1.0.0", "2.0.0", "1.5.0")
+	latest := Latest(vs)
+	if latest.String() != "2" {
+		t.Errorf("Latest() = %q, want 2", latest.String())
+	}
+	if Latest(nil) != nil {
+		t.Errorf("Latest(nil) should be nil")
+	}
+}
+
+func TestLatestStable(t *testing.T) {
+	vs := mustVersions(t, "1.0.0", "2.0.0-alpha", "1.5.0")
+	latest := LatestStable(vs)
+	if latest.String() != "1.5" {
+		t.Errorf("LatestStable() = %q, want 1.5", latest.String())
+	}
+}
+
+func TestFilter(t *testing.T) {
+	vs := mustVersions(t, "1.0.0", "1.5.0", "2.0.0")
+	r, err := ParseRange(">=1.2.0 <2.0.0")
+	if err != nil {
+		t.Fatalf("ParseRange: %v", err)
+	}
+	got := Filter(vs, r)
+	if len(got) != 1 || got[0].String() != "1.5" {
+		t.Errorf("Filter() = %v, want [1.5]", got)
+	}
+}
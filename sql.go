@@ -0,0 +1,33 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !semver_nosql
+
+package semver
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan implements database/sql.Scanner, reading a Version from a TEXT/VARCHAR
+// column.
+func (t *Version) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case string:
+		return t.Parse(v)
+	case []byte:
+		return t.Parse(string(v))
+	case nil:
+		*t = Version{}
+		return nil
+	default:
+		return fmt.Errorf("semver: cannot scan %T into Version", src)
+	}
+}
+
+// Value implements database/sql/driver.Valuer, encoding t as its String().
+func (t *Version) Value() (driver.Value, error) {
+	return t.String(), nil
+}
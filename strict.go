@@ -0,0 +1,209 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// strictPattern implements the SemVer 2.0.0 grammar:
+// https://semver.org/#backusnaur-form-grammar-for-valid-semver-versions
+var strictPattern = regexp.MustCompile(
+	`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*))?$`,
+)
+
+// NewStrictVersion parses str as a SemVer 2.0.0 version, rejecting any
+// input that doesn't conform to the spec grammar (no '_' separators, no
+// four-component versions, no release word without a '-' such as
+// "1.2.3alpha"). Words such as "alpha" after the '-' are kept as ordinary
+// pre-release identifiers, not as release-type keywords.
+// Use NewVersion instead to keep accepting this package's historical,
+// more permissive grammar.
+func NewStrictVersion(str string) (*Version, error) {
+	m := strictPattern.FindStringSubmatch(str)
+	if m == nil {
+		return nil, ErrInvalidVersionString
+	}
+
+	ver := &Version{strict: true}
+	for i, dst := range []*int{&ver.version[0], &ver.version[1], &ver.version[2]} {
+		n, err := strconv.Atoi(m[i+1])
+		if err != nil {
+			return nil, err
+		}
+		*dst = n
+	}
+	if m[4] != "" {
+		ver.preIdents = strings.Split(m[4], ".")
+	}
+	ver.buildMeta = m[5]
+
+	return ver, nil
+}
+
+// strictString reconstructs the SemVer 2.0.0 textual form of a Version
+// parsed by NewStrictVersion.
+func (t *Version) strictString() string {
+	var b strings.Builder
+	b.WriteString(strconv.Itoa(t.version[0]))
+	b.WriteByte('.')
+	b.WriteString(strconv.Itoa(t.version[1]))
+	b.WriteByte('.')
+	b.WriteString(strconv.Itoa(t.version[2]))
+	if len(t.preIdents) > 0 {
+		b.WriteByte('-')
+		b.WriteString(strings.Join(t.preIdents, "."))
+	}
+	if t.buildMeta != "" {
+		b.WriteByte('+')
+		b.WriteString(t.buildMeta)
+	}
+	return b.String()
+}
+
+// compareStrict compares two Versions, at least one of which was parsed by
+// NewStrictVersion or ParseCalendar, following the SemVer 2.0.0 precedence
+// rules: compare major.minor.patch numerically, then releaseCategory (a
+// legacy post-release such as "-r1"/"-p1" outranks a plain release, which
+// in turn outranks a pre-release), then, within the pre-release category,
+// the pre-release identifiers themselves; build metadata is ignored.
+func compareStrict(a, b Version) int {
+	if d := signDelta(a.version, b.version, 3); d != 0 {
+		return int(d)
+	}
+	ca, cb := releaseCategory(a), releaseCategory(b)
+	switch {
+	case ca < cb:
+		return -1
+	case ca > cb:
+		return 1
+	case ca > 0:
+		// Both legacy post-release (revision/patch, the only grammar with
+		// this category); compare the rest of the ordinal tail the same
+		// way same-grammar Compare would.
+		return int(signDelta(a.version, b.version, 14))
+	case ca < 0:
+		return compareIdentifiers(preReleaseIdentifiers(a), preReleaseIdentifiers(b))
+	default:
+		return 0
+	}
+}
+
+// releaseCategory classifies v's release type into the three tiers that
+// precedence compares before anything else: -1 pre-release (ranks lowest),
+// 0 plain release, 1 legacy post-release such as "-r1"/"-p1" (ranks
+// highest). Strict/calendar Versions have no post-release concept, so they
+// are always -1 or 0.
+func releaseCategory(v Version) int {
+	if v.specGrammar() {
+		if len(v.preIdents) > 0 {
+			return -1
+		}
+		return 0
+	}
+	switch {
+	case v.version[idxReleaseType] < common:
+		return -1
+	case v.version[idxReleaseType] > common:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// preReleaseIdentifiers returns v's pre-release identifiers, bridging the
+// legacy keyword-aware representation (release type word plus numeric
+// columns) into the same ordered-identifier shape used by strict Versions.
+// Only meaningful when releaseCategory(v) < 0; callers must check that
+// first, since a legacy post-release type word (e.g. "r1") is not a
+// pre-release identifier.
+func preReleaseIdentifiers(v Version) []string {
+	if v.specGrammar() {
+		return v.preIdents
+	}
+	if v.version[idxReleaseType] >= common {
+		return nil
+	}
+	ids := []string{releaseDesc[v.version[idxReleaseType]]}
+	for _, c := range v.version[idxRelease : idxRelease+4] {
+		if c != 0 {
+			ids = append(ids, strconv.Itoa(c))
+		}
+	}
+	return ids
+}
+
+// compareIdentifiers compares two ordered pre-release identifier lists per
+// the SemVer 2.0.0 rules: no pre-release outranks any pre-release; shared
+// identifiers compare via compareIdentifier in order; if all shared
+// identifiers are equal, the shorter list is lower precedence.
+func compareIdentifiers(a, b []string) int {
+	switch {
+	case len(a) == 0 && len(b) == 0:
+		return 0
+	case len(a) == 0:
+		return 1
+	case len(b) == 0:
+		return -1
+	}
+
+	n := len(a)
+	if len(b) < n {
+		n = len(b)
+	}
+	for i := 0; i < n; i++ {
+		if c := compareIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(a) < len(b):
+		return -1
+	case len(a) > len(b):
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifier compares a single pair of pre-release identifiers:
+// numeric identifiers compare numerically and sort lower than any
+// alphanumeric identifier, which compare lexically in ASCII order.
+func compareIdentifier(a, b string) int {
+	an, aIsNum := asNumericIdentifier(a)
+	bn, bIsNum := asNumericIdentifier(b)
+	switch {
+	case aIsNum && bIsNum:
+		switch {
+		case an < bn:
+			return -1
+		case an > bn:
+			return 1
+		default:
+			return 0
+		}
+	case aIsNum && !bIsNum:
+		return -1
+	case !aIsNum && bIsNum:
+		return 1
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func asNumericIdentifier(s string) (int, bool) {
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return n, true
+}
@@ -0,0 +1,89 @@
+// Copyright 2014 The Semver Package Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package semver
+
+import "testing"
+
+func TestNewStrictVersionRejects(t *testing.T) {
+	cases := []string{
+		"1.2.3_alpha", // '_' is not a valid separator
+		"1.2.3.4",     // four components
+		"1.2",         // patch is required
+		"01.2.3",      // leading zero
+		"1.2.3-01",    // leading zero in a pre-release identifier
+		"",
+	}
+	for _, c := range cases {
+		if _, err := NewStrictVersion(c); err == nil {
+			t.Errorf("NewStrictVersion(%q): expected error, got none", c)
+		}
+	}
+}
+
+func TestNewStrictVersionRoundTrip(t *testing.T) {
+	cases := []string{
+		"1.2.3",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-x.7.z.92",
+		"1.0.0-0",
+		"1.0.0-0alpha",
+		"1.0.0+build.42",
+		"1.0.0-beta+exp.sha.5114f85",
+	}
+	for _, c := range cases {
+		v, err := NewStrictVersion(c)
+		if err != nil {
+			t.Fatalf("NewStrictVersion(%q): %v", c, err)
+		}
+		if got := v.String(); got != c {
+			t.Errorf("NewStrictVersion(%q).String() = %q", c, got)
+		}
+	}
+}
+
+func TestStrictPrecedence(t *testing.T) {
+	// https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+	}
+	for i := 1; i < len(ordered); i++ {
+		prev, err := NewStrictVersion(ordered[i-1])
+		if err != nil {
+			t.Fatalf("NewStrictVersion(%q): %v", ordered[i-1], err)
+		}
+		cur, err := NewStrictVersion(ordered[i])
+		if err != nil {
+			t.Fatalf("NewStrictVersion(%q): %v", ordered[i], err)
+		}
+		if !prev.Less(cur) {
+			t.Errorf("expected %q < %q", ordered[i-1], ordered[i])
+		}
+		if Compare(*cur, *prev) != 1 {
+			t.Errorf("expected Compare(%q, %q) == 1", ordered[i], ordered[i-1])
+		}
+	}
+}
+
+func TestCompareStrictAndLegacyMajorMinorPatch(t *testing.T) {
+	strict, err := NewStrictVersion("1.2.3")
+	if err != nil {
+		t.Fatalf("NewStrictVersion: %v", err)
+	}
+	legacy, err := NewVersion("1.2.4")
+	if err != nil {
+		t.Fatalf("NewVersion: %v", err)
+	}
+	if !strict.Less(legacy) {
+		t.Errorf("expected 1.2.3 < 1.2.4 across strict/legacy Versions")
+	}
+}